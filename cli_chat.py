@@ -17,6 +17,7 @@ from langchain_mcp_adapters.client import MultiServerMCPClient
 
 import re
 
+from src.config import config as app_config, load_mcp_config
 from src.handlers import UsageTrackingHandler
 from src.prompts.system_prompt import SystemPrompt
 from src.llm_factory import LLMFactory
@@ -100,13 +101,12 @@ def format_log_to_messages(intermediate_steps):
     return messages
 
 def load_config():
-    """Load configuration from mcp_config.json"""
-    try:
-        with open("mcp_config.json", "r") as f:
-            return json.load(f)
-    except Exception as e:
-        logging.error(f"Error loading config: {e}")
-        return {"llm": {"provider": "anthropic", "settings": {}}}
+    """Load configuration from mcp_config.json in the project root
+
+    A missing file yields no MCP servers and the default LLM; a malformed
+    one raises ValueError rather than silently falling back.
+    """
+    return load_mcp_config(app_config.config_file)
 
 async def setup_agent(memory_manager: MemoryManager, conversation_id: str, context_window: int = 10):
     print("Setting up agent")
@@ -4,6 +4,34 @@ from pathlib import Path
 from typing import Dict, Any, Optional
 from dotenv import load_dotenv
 
+def load_mcp_config(config_file: Path) -> Dict[str, Any]:
+    """Load MCP server configuration from JSON, failing loudly if it is invalid"""
+    if not config_file.exists():
+        return {"mcpServers": {}}
+
+    # A present but invalid file is an operator error, so fail loudly
+    # instead of silently running without any MCP servers
+    with open(config_file) as f:
+        try:
+            mcp_config = json.load(f)
+        except json.JSONDecodeError as e:
+            raise ValueError(
+                f"Invalid JSON in {config_file} at line {e.lineno}, column {e.colno}: {e.msg}"
+            ) from e
+
+    if not isinstance(mcp_config, dict):
+        raise ValueError(f"Invalid config in {config_file}: expected a JSON object at the top level")
+
+    servers = mcp_config.get('mcpServers', {})
+    if not isinstance(servers, dict):
+        raise ValueError(f"Invalid config in {config_file}: 'mcpServers' must be a JSON object")
+    for server_name, server_config in servers.items():
+        if not isinstance(server_config, dict):
+            raise ValueError(
+                f"Invalid config in {config_file}: 'mcpServers.{server_name}' must be a JSON object"
+            )
+    return mcp_config
+
 class Config:
     # Application settings
     PAGE_TITLE = "MCP Client"
@@ -38,11 +66,7 @@ class Config:
             
     def _load_mcp_config(self) -> Dict[str, Any]:
         """Load MCP server configuration from JSON"""
-        if not self.config_file.exists():
-            return {"mcpServers": {}}
-            
-        with open(self.config_file) as f:
-            return json.load(f)
+        return load_mcp_config(self.config_file)
 
     def _apply_env_overrides(self):
         """Apply environment variable overrides to server configs"""
@@ -0,0 +1,61 @@
+import json
+
+import pytest
+
+from src.config import load_mcp_config
+
+
+@pytest.fixture
+def config_file(tmp_path):
+    return tmp_path / 'mcp_config.json'
+
+
+def test_missing_file_returns_empty_servers(config_file):
+    assert load_mcp_config(config_file) == {"mcpServers": {}}
+
+
+def test_valid_file_is_returned_unchanged(config_file):
+    data = {
+        "llm": {"provider": "anthropic", "settings": {"temperature": 0}},
+        "mcpServers": {"alpha": {"url": "http://localhost:8000/api/mcp", "transport": "sse"}}
+    }
+    config_file.write_text(json.dumps(data))
+
+    assert load_mcp_config(config_file) == data
+
+
+@pytest.mark.parametrize("content, line, column", [
+    ('{bad', 1, 2),
+    ('', 1, 1),
+    ('{\n  "mcpServers": {,}\n}', 2, 18),
+])
+def test_malformed_json_reports_path_line_and_column(config_file, content, line, column):
+    config_file.write_text(content)
+
+    with pytest.raises(ValueError) as exc_info:
+        load_mcp_config(config_file)
+
+    message = str(exc_info.value)
+    assert str(config_file) in message
+    assert f"line {line}, column {column}" in message
+
+
+def test_non_object_top_level_is_rejected(config_file):
+    config_file.write_text('[]')
+
+    with pytest.raises(ValueError, match="top level"):
+        load_mcp_config(config_file)
+
+
+@pytest.mark.parametrize("servers, bad_key", [
+    ([], "'mcpServers'"),
+    ({"alpha": "x"}, "'mcpServers.alpha'"),
+])
+def test_invalid_servers_shape_names_bad_key(config_file, servers, bad_key):
+    config_file.write_text(json.dumps({"mcpServers": servers}))
+
+    with pytest.raises(ValueError) as exc_info:
+        load_mcp_config(config_file)
+
+    assert str(config_file) in str(exc_info.value)
+    assert bad_key in str(exc_info.value)